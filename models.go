@@ -1,6 +1,34 @@
 package sonarr
 
-import "time"
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Image of a Series, e.g. a poster, banner, or fanart.
+type Image struct {
+	CoverType string `json:"coverType"`
+	URL       string `json:"url"`
+	RemoteURL string `json:"remoteUrl"`
+}
+
+// SeasonStatistics about the episodes and files available for a Season.
+type SeasonStatistics struct {
+	PreviousAiring    time.Time `json:"previousAiring"`
+	EpisodeFileCount  int       `json:"episodeFileCount"`
+	EpisodeCount      int       `json:"episodeCount"`
+	TotalEpisodeCount int       `json:"totalEpisodeCount"`
+	SizeOnDisk        int       `json:"sizeOnDisk"`
+	PercentOfEpisodes int       `json:"percentOfEpisodes"`
+}
+
+// Season of a Series.
+type Season struct {
+	SeasonNumber int              `json:"seasonNumber"`
+	Monitored    bool             `json:"monitored"`
+	Statistics   SeasonStatistics `json:"statistics"`
+}
 
 // Series stored on the Sonarr server.
 type Series struct {
@@ -20,21 +48,8 @@ type Series struct {
 	PreviousAiring    time.Time `json:"previousAiring"`
 	Network           string    `json:"network"`
 	AirTime           string    `json:"airTime"`
-	Images            []struct {
-		CoverType string `json:"coverType"`
-	} `json:"images"`
-	Seasons []struct {
-		SeasonNumber int  `json:"seasonNumber"`
-		Monitored    bool `json:"monitored"`
-		Statistics   struct {
-			PreviousAiring    time.Time `json:"previousAiring"`
-			EpisodeFileCount  int       `json:"episodeFileCount"`
-			EpisodeCount      int       `json:"episodeCount"`
-			TotalEpisodeCount int       `json:"totalEpisodeCount"`
-			SizeOnDisk        int       `json:"sizeOnDisk"`
-			PercentOfEpisodes int       `json:"percentOfEpisodes"`
-		} `json:"statistics"`
-	} `json:"seasons"`
+	Images            []Image   `json:"images"`
+	Seasons           []Season  `json:"seasons"`
 	Year              int       `json:"year"`
 	Path              string    `json:"path"`
 	ProfileID         int       `json:"profileId"`
@@ -60,9 +75,102 @@ type Series struct {
 		Value float32 `json:"value"`
 	} `json:"ratings"`
 	QualityProfileID int `json:"qualityProfileId"`
+	// LanguageProfileID is populated on Sonarr v3 and later, which splits
+	// the v2 ProfileID into separate quality and language profiles.
+	LanguageProfileID int `json:"languageProfileId"`
+	// MonitorNewItems controls whether newly added seasons are monitored
+	// automatically. It is a Sonarr v3 and later setting.
+	MonitorNewItems string `json:"monitorNewItems"`
+	// Statistics holds the episode/file counts Sonarr v3 and later nest
+	// under this key instead of returning at the top level of Series.
+	Statistics SeriesStatistics `json:"statistics"`
+	ID         int              `json:"id"`
+}
+
+// SeriesStatistics about the episodes and files available for a Series.
+type SeriesStatistics struct {
+	SeasonCount       int `json:"seasonCount"`
+	EpisodeFileCount  int `json:"episodeFileCount"`
+	EpisodeCount      int `json:"episodeCount"`
+	TotalEpisodeCount int `json:"totalEpisodeCount"`
+	SizeOnDisk        int `json:"sizeOnDisk"`
+	PercentOfEpisodes int `json:"percentOfEpisodes"`
+}
+
+// SeriesLookupResult is a Series match found by searching an external
+// database such as TheTVDB. It has not necessarily been added to Sonarr yet,
+// so ID will be 0 unless the series is already in the library.
+type SeriesLookupResult struct {
+	Title           string `json:"title"`
+	AlternateTitles []struct {
+		Title        string `json:"title"`
+		SeasonNumber int    `json:"seasonNumber"`
+	} `json:"alternateTitles"`
+	SortTitle     string    `json:"sortTitle"`
+	SeasonCount   int       `json:"seasonCount"`
+	Status        string    `json:"status"`
+	Overview      string    `json:"overview"`
+	Network       string    `json:"network"`
+	AirTime       string    `json:"airTime"`
+	Images        []Image   `json:"images"`
+	RemotePoster  string    `json:"remotePoster"`
+	Seasons       []Season  `json:"seasons"`
+	Year          int       `json:"year"`
+	ProfileID     int       `json:"profileId"`
+	SeasonFolder  bool      `json:"seasonFolder"`
+	Monitored     bool      `json:"monitored"`
+	Runtime       int       `json:"runtime"`
+	TvdbID        int       `json:"tvdbId"`
+	TvRageID      int       `json:"tvRageId"`
+	TvMazeID      int       `json:"tvMazeId"`
+	FirstAired    time.Time `json:"firstAired"`
+	SeriesType    string    `json:"seriesType"`
+	CleanTitle    string    `json:"cleanTitle"`
+	ImdbID        string    `json:"imdbId"`
+	TitleSlug     string    `json:"titleSlug"`
+	Certification string    `json:"certification"`
+	Genres        []string  `json:"genres"`
+	Tags          []int     `json:"tags"`
+	Added         time.Time `json:"added"`
+	Ratings       struct {
+		Votes int     `json:"votes"`
+		Value float32 `json:"value"`
+	} `json:"ratings"`
+	QualityProfileID int `json:"qualityProfileId"`
 	ID               int `json:"id"`
 }
 
+// AddSeriesSeason identifies a Season and its monitored status when adding a
+// new Series with AddSeries.
+type AddSeriesSeason struct {
+	SeasonNumber int  `json:"seasonNumber"`
+	Monitored    bool `json:"monitored"`
+}
+
+// AddOptions controls episode search behavior when a Series is added with
+// AddSeries.
+type AddOptions struct {
+	SearchForMissingEpisodes   bool `json:"searchForMissingEpisodes"`
+	IgnoreEpisodesWithFiles    bool `json:"ignoreEpisodesWithFiles"`
+	IgnoreEpisodesWithoutFiles bool `json:"ignoreEpisodesWithoutFiles"`
+}
+
+// AddSeriesOptions is the payload required to add a new Series to Sonarr,
+// typically built from a SeriesLookupResult returned by LookupSeries.
+type AddSeriesOptions struct {
+	TvdbID            int               `json:"tvdbId"`
+	Title             string            `json:"title"`
+	TitleSlug         string            `json:"titleSlug"`
+	QualityProfileID  int               `json:"qualityProfileId"`
+	LanguageProfileID int               `json:"languageProfileId"`
+	RootFolderPath    string            `json:"rootFolderPath"`
+	SeasonFolder      bool              `json:"seasonFolder"`
+	Monitored         bool              `json:"monitored"`
+	Tags              []int             `json:"tags"`
+	Seasons           []AddSeriesSeason `json:"seasons"`
+	AddOptions        AddOptions        `json:"addOptions"`
+}
+
 // Episode of a Series.
 type Episode struct {
 	SeriesID                 int       `json:"seriesId"`
@@ -103,7 +211,16 @@ type EpisodeFile struct {
 	SceneName           string  `json:"sceneName"`
 	Quality             Quality `json:"quality"`
 	QualityCutoffNotMet bool    `json:"qualityCutoffNotMet"`
-	ID                  int     `json:"id"`
+	// Languages is populated on Sonarr v3 and later, which moved language
+	// from a Series-level setting to a per-file list.
+	Languages []Language `json:"languages"`
+	ID        int        `json:"id"`
+}
+
+// Language a release or file was found or tagged in.
+type Language struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 // Queue item currently being downloaded.
@@ -125,6 +242,99 @@ type Queue struct {
 	ID         int    `json:"id"`
 }
 
+// PageOptions controls paging, sorting, and filtering for list endpoints
+// that support Sonarr's standard paging params. A zero value requests
+// Sonarr's defaults.
+type PageOptions struct {
+	Page        int
+	PageSize    int
+	SortKey     string
+	SortDir     string
+	FilterKey   string
+	FilterValue string
+}
+
+func (o PageOptions) values() url.Values {
+	params := make(url.Values)
+	if o.Page > 0 {
+		params.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		params.Set("pageSize", strconv.Itoa(o.PageSize))
+	}
+	if o.SortKey != "" {
+		params.Set("sortKey", o.SortKey)
+	}
+	if o.SortDir != "" {
+		params.Set("sortDir", o.SortDir)
+	}
+	if o.FilterKey != "" {
+		params.Set("filterKey", o.FilterKey)
+	}
+	if o.FilterValue != "" {
+		params.Set("filterValue", o.FilterValue)
+	}
+	return params
+}
+
+// HistoryOptions controls paging and filtering for GetHistory, in addition
+// to the standard PageOptions.
+type HistoryOptions struct {
+	PageOptions
+	EpisodeID int
+}
+
+func (o HistoryOptions) values() url.Values {
+	params := o.PageOptions.values()
+	if o.EpisodeID > 0 {
+		params.Set("episodeId", strconv.Itoa(o.EpisodeID))
+	}
+	return params
+}
+
+// PagedResult wraps a single page of records from one of Sonarr's paged
+// endpoints.
+type PagedResult[T any] struct {
+	Page         int    `json:"page"`
+	PageSize     int    `json:"pageSize"`
+	SortKey      string `json:"sortKey"`
+	SortDir      string `json:"sortDirection"`
+	TotalRecords int    `json:"totalRecords"`
+	Records      []T    `json:"records"`
+}
+
+// HistoryEventType describes what happened in a HistoryRecord.
+type HistoryEventType int
+
+// History event types, matching Sonarr's EventType enum.
+const (
+	HistoryEventTypeUnknown HistoryEventType = iota
+	HistoryEventTypeGrabbed
+	HistoryEventTypeSeriesFolderImported
+	HistoryEventTypeDownloadFolderImported
+	HistoryEventTypeDownloadFailed
+	HistoryEventTypeEpisodeFileDeleted
+	HistoryEventTypeEpisodeFileRenamed
+	HistoryEventTypeDownloadIgnored
+)
+
+// HistoryRecord is a single event in a Series or Episode's history, such as
+// a grab, import, or deletion.
+type HistoryRecord struct {
+	ID                  int               `json:"id"`
+	EpisodeID           int               `json:"episodeId"`
+	SeriesID            int               `json:"seriesId"`
+	SourceTitle         string            `json:"sourceTitle"`
+	Quality             Quality           `json:"quality"`
+	QualityCutoffNotMet bool              `json:"qualityCutoffNotMet"`
+	Date                time.Time         `json:"date"`
+	DownloadID          string            `json:"downloadId"`
+	EventType           HistoryEventType  `json:"eventType"`
+	Data                map[string]string `json:"data"`
+	Episode             Episode           `json:"episode"`
+	Series              Series            `json:"series"`
+}
+
 // Calendar entry for a past or upcoming airing.
 type Calendar struct {
 	SeriesID                 int       `json:"seriesId"`
@@ -155,6 +365,62 @@ type Tag struct {
 	ID    int    `json:"id"`
 }
 
+// QualityProfileItem sets whether a Quality is allowed under a
+// QualityProfile.
+type QualityProfileItem struct {
+	Quality struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"quality"`
+	Allowed bool `json:"allowed"`
+}
+
+// QualityProfile determines which release qualities are acceptable for a
+// Series and which quality an upgrade should stop at.
+type QualityProfile struct {
+	Name        string               `json:"name"`
+	Cutoff      int                  `json:"cutoff"`
+	Items       []QualityProfileItem `json:"items"`
+	FormatItems []struct {
+		Format int    `json:"format"`
+		Name   string `json:"name"`
+	} `json:"formatItems"`
+	ID int `json:"id"`
+}
+
+// LanguageProfileItem sets whether a language is allowed under a
+// LanguageProfile.
+type LanguageProfileItem struct {
+	Language struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"language"`
+	Allowed bool `json:"allowed"`
+}
+
+// LanguageProfile determines which release languages are acceptable for a
+// Series and which language an upgrade should stop at.
+type LanguageProfile struct {
+	Name   string `json:"name"`
+	Cutoff struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"cutoff"`
+	Languages []LanguageProfileItem `json:"languages"`
+	ID        int                   `json:"id"`
+}
+
+// RootFolder is a base path Sonarr can store a Series under.
+type RootFolder struct {
+	Path            string `json:"path"`
+	FreeSpace       int    `json:"freeSpace"`
+	UnmappedFolders []struct {
+		Name string `json:"name"`
+		Path string `json:"path"`
+	} `json:"unmappedFolders"`
+	ID int `json:"id"`
+}
+
 // SystemStatus of the server.
 type SystemStatus struct {
 	Version           string `json:"version"`
@@ -179,3 +445,54 @@ type SystemStatus struct {
 	RuntimeVersion    string `json:"runtimeVersion"`
 	RuntimeName       string `json:"runtimeName"`
 }
+
+// Command represents a task queued or running on the Sonarr server, such as
+// a series refresh or an episode search.
+type Command struct {
+	ID        int                    `json:"id"`
+	Name      string                 `json:"name"`
+	Status    string                 `json:"status"`
+	State     string                 `json:"state"`
+	QueuedAt  time.Time              `json:"queued"`
+	StartedAt time.Time              `json:"started"`
+	EndedAt   time.Time              `json:"ended"`
+	Duration  string                 `json:"duration"`
+	Exception string                 `json:"exception"`
+	Trigger   string                 `json:"trigger"`
+	Body      map[string]interface{} `json:"body"`
+}
+
+// Release is a single result from an interactive search, as returned by
+// GetReleases and DownloadRelease.
+type Release struct {
+	GUID            string     `json:"guid"`
+	Title           string     `json:"title"`
+	Quality         Quality    `json:"quality"`
+	Languages       []Language `json:"languages"`
+	Size            int64      `json:"size"`
+	IndexerID       int        `json:"indexerId"`
+	Indexer         string     `json:"indexer"`
+	ReleaseGroup    string     `json:"releaseGroup"`
+	Age             int        `json:"age"`
+	Protocol        string     `json:"protocol"`
+	DownloadAllowed bool       `json:"downloadAllowed"`
+	Rejections      []string   `json:"rejections"`
+}
+
+// ParsedEpisodeInfo is the release information Parse extracted from a
+// release title.
+type ParsedEpisodeInfo struct {
+	SeriesTitle    string  `json:"seriesTitle"`
+	SeasonNumber   int     `json:"seasonNumber"`
+	EpisodeNumbers []int   `json:"episodeNumbers"`
+	Quality        Quality `json:"quality"`
+	ReleaseGroup   string  `json:"releaseGroup"`
+}
+
+// ParseResult is the result of parsing a release title with Parse.
+type ParseResult struct {
+	Title             string            `json:"title"`
+	ParsedEpisodeInfo ParsedEpisodeInfo `json:"parsedEpisodeInfo"`
+	Series            Series            `json:"series"`
+	Episodes          []Episode         `json:"episodes"`
+}
@@ -1,6 +1,7 @@
 package sonarr
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,9 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
 )
 
 // Sonarr contains fields needed to make API calls to a Sonarr server
@@ -15,20 +19,103 @@ type Sonarr struct {
 	baseURL    *url.URL
 	apiKey     string
 	HTTPClient http.Client
+
+	userAgent  string
+	retry      RetryConfig
+	limiter    *rate.Limiter
+	apiVersion APIVersion
+}
+
+// defaultRetry is used by New unless overridden with WithRetry.
+var defaultRetry = RetryConfig{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   10 * time.Second,
 }
 
+// APIVersion selects which Sonarr API path new requests are made under.
+type APIVersion string
+
 const (
-	calendarEndpoint     = "calendar"
-	diskSpaceEndpoint    = "diskspace"
-	episodeEndpoint      = "episode"
-	episodeFileEndpoint  = "episodefile"
-	seriesEndpoint       = "series"
-	systemStatusEndpoint = "system/status"
-	tagEndpoint          = "tag"
+	// APIv2 is Sonarr's legacy, unversioned API and is used by default.
+	APIv2 APIVersion = ""
+	// APIv3 is Sonarr's versioned API, required by Sonarr v3 and later.
+	APIv3 APIVersion = "api/v3/"
+)
+
+// Option customizes a Sonarr client created with New.
+type Option func(*Sonarr)
+
+// WithHTTPClient sets the http.Client used to make requests, e.g. to
+// configure TLS settings or a request timeout.
+func WithHTTPClient(client http.Client) Option {
+	return func(s *Sonarr) {
+		s.HTTPClient = client
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(s *Sonarr) {
+		s.userAgent = userAgent
+	}
+}
+
+// WithRetry configures how requests are retried when Sonarr responds with a
+// 5xx status or a 429 (Too Many Requests). Set MaxRetries to 0 to disable
+// retries entirely.
+func WithRetry(retry RetryConfig) Option {
+	return func(s *Sonarr) {
+		s.retry = retry
+	}
+}
+
+// WithRateLimit throttles outgoing requests to the given rate.Limiter before
+// they are sent, in addition to any retry behavior configured with
+// WithRetry.
+func WithRateLimit(limiter *rate.Limiter) Option {
+	return func(s *Sonarr) {
+		s.limiter = limiter
+	}
+}
+
+// WithAPIVersion selects which Sonarr API path requests are made under.
+// Sonarr v3 and later require APIv3; defaults to APIv2 for compatibility
+// with older servers.
+func WithAPIVersion(version APIVersion) Option {
+	return func(s *Sonarr) {
+		s.apiVersion = version
+	}
+}
+
+const (
+	calendarEndpoint        = "calendar"
+	commandEndpoint         = "command"
+	diskSpaceEndpoint       = "diskspace"
+	episodeEndpoint         = "episode"
+	episodeFileEndpoint     = "episodefile"
+	historyEndpoint         = "history"
+	languageProfileEndpoint = "languageprofile"
+	qualityProfileEndpoint  = "qualityprofile"
+	parseEndpoint           = "parse"
+	queueEndpoint           = "queue"
+	releaseEndpoint         = "release"
+	rootFolderEndpoint      = "rootfolder"
+	seriesEndpoint          = "series"
+	seriesLookupEndpoint    = "series/lookup"
+	systemStatusEndpoint    = "system/status"
+	tagEndpoint             = "tag"
+	wantedCutoffEndpoint    = "wanted/cutoff"
+	wantedMissingEndpoint   = "wanted/missing"
 )
 
 // New creates a new Sonarr client instance.
-func New(apiURL, apiKey string) (*Sonarr, error) {
+//
+// There is deliberately no WithContext option: every exported method takes
+// a ctx context.Context as its first parameter instead, so callers can scope
+// cancellation/timeouts to a single call rather than the client's whole
+// lifetime.
+func New(apiURL, apiKey string, opts ...Option) (*Sonarr, error) {
 	if apiURL == "" {
 		return &Sonarr{}, errors.New("apiURL is required")
 	}
@@ -46,16 +133,23 @@ func New(apiURL, apiKey string) (*Sonarr, error) {
 		return &Sonarr{}, fmt.Errorf("Failed to parse baseURL: %v", err)
 	}
 
-	return &Sonarr{
+	s := &Sonarr{
 		baseURL:    baseURL,
 		apiKey:     apiKey,
 		HTTPClient: http.Client{},
-	}, nil
+		retry:      defaultRetry,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s, nil
 }
 
 // GetCalendar retrieves info about when episodes were/will be downloaded.
 // If start and end are not provided, retrieves episodes airing today and tomorrow.
-func (s *Sonarr) GetCalendar(start, end string) ([]Calendar, error) {
+func (s *Sonarr) GetCalendar(ctx context.Context, start, end string) ([]Calendar, error) {
 	params := make(url.Values)
 	if start != "" {
 		params.Set("start", start)
@@ -64,7 +158,7 @@ func (s *Sonarr) GetCalendar(start, end string) ([]Calendar, error) {
 		params.Set("end", end)
 	}
 	var results []Calendar
-	res, err := s.get(calendarEndpoint, params)
+	res, err := s.get(ctx, calendarEndpoint, params)
 	if err != nil {
 		return results, err
 	}
@@ -75,9 +169,9 @@ func (s *Sonarr) GetCalendar(start, end string) ([]Calendar, error) {
 }
 
 // GetDiskSpace retrieves info about the disk space remaining on the server.
-func (s *Sonarr) GetDiskSpace() ([]DiskSpace, error) {
+func (s *Sonarr) GetDiskSpace(ctx context.Context) ([]DiskSpace, error) {
 	var results []DiskSpace
-	res, err := s.get(diskSpaceEndpoint, nil)
+	res, err := s.get(ctx, diskSpaceEndpoint, nil)
 	if err != nil {
 		return results, err
 	}
@@ -88,14 +182,14 @@ func (s *Sonarr) GetDiskSpace() ([]DiskSpace, error) {
 }
 
 // GetEpisodes retrieves all Episodes for the given series ID.
-func (s *Sonarr) GetEpisodes(seriesID int) ([]Episode, error) {
+func (s *Sonarr) GetEpisodes(ctx context.Context, seriesID int) ([]Episode, error) {
 	var results []Episode
 	if seriesID <= 0 {
 		return results, errors.New("seriesID must be a positive integer")
 	}
 	params := make(url.Values)
 	params.Set("seriesId", strconv.Itoa(seriesID))
-	res, err := s.get(episodeEndpoint, params)
+	res, err := s.get(ctx, episodeEndpoint, params)
 	if err != nil {
 		return results, err
 	}
@@ -106,13 +200,13 @@ func (s *Sonarr) GetEpisodes(seriesID int) ([]Episode, error) {
 }
 
 // GetEpisode retrieves the Episode with the given ID.
-func (s *Sonarr) GetEpisode(episodeID int) (*Episode, error) {
+func (s *Sonarr) GetEpisode(ctx context.Context, episodeID int) (*Episode, error) {
 	results := &Episode{}
 	if episodeID <= 0 {
 		return results, errors.New("episodeID must be a positive integer")
 	}
 	episodeURL := fmt.Sprintf("%s/%s", episodeEndpoint, strconv.Itoa(episodeID))
-	res, err := s.get(episodeURL, nil)
+	res, err := s.get(ctx, episodeURL, nil)
 	if err != nil {
 		return results, err
 	}
@@ -126,10 +220,10 @@ func (s *Sonarr) GetEpisode(episodeID int) (*Episode, error) {
 // updating the "Monitored" status. Any other changes are ignored.
 // This should be an Episode you have previously retrieved with GetEpisodes()
 // or GetEpisode(). The updated Episode is returned.
-func (s *Sonarr) UpdateEpisode(ep *Episode) (*Episode, error) {
+func (s *Sonarr) UpdateEpisode(ctx context.Context, ep *Episode) (*Episode, error) {
 	results := &Episode{}
 	episodeURL := fmt.Sprintf("%s/%s", episodeEndpoint, strconv.Itoa(ep.ID))
-	res, err := s.put(episodeURL, ep)
+	res, err := s.put(ctx, episodeURL, ep)
 	if err != nil {
 		return results, err
 	}
@@ -140,14 +234,14 @@ func (s *Sonarr) UpdateEpisode(ep *Episode) (*Episode, error) {
 }
 
 // GetEpisodeFiles retrieves all EpisodeFiles for the given series ID.
-func (s *Sonarr) GetEpisodeFiles(seriesID int) ([]EpisodeFile, error) {
+func (s *Sonarr) GetEpisodeFiles(ctx context.Context, seriesID int) ([]EpisodeFile, error) {
 	var results []EpisodeFile
 	if seriesID <= 0 {
 		return results, errors.New("seriesID must be a positive integer")
 	}
 	params := make(url.Values)
 	params.Set("seriesId", strconv.Itoa(seriesID))
-	res, err := s.get(episodeFileEndpoint, params)
+	res, err := s.get(ctx, episodeFileEndpoint, params)
 	if err != nil {
 		return results, err
 	}
@@ -158,13 +252,13 @@ func (s *Sonarr) GetEpisodeFiles(seriesID int) ([]EpisodeFile, error) {
 }
 
 // GetEpisodeFile retrieves the EpisodeFile with the given ID.
-func (s *Sonarr) GetEpisodeFile(episodeFileID int) (*EpisodeFile, error) {
+func (s *Sonarr) GetEpisodeFile(ctx context.Context, episodeFileID int) (*EpisodeFile, error) {
 	results := &EpisodeFile{}
 	if episodeFileID <= 0 {
 		return results, errors.New("episodeFileID must be a positive integer")
 	}
 	episodeFileURL := fmt.Sprintf("%s/%s", episodeFileEndpoint, strconv.Itoa(episodeFileID))
-	res, err := s.get(episodeFileURL, nil)
+	res, err := s.get(ctx, episodeFileURL, nil)
 	if err != nil {
 		return results, err
 	}
@@ -176,13 +270,13 @@ func (s *Sonarr) GetEpisodeFile(episodeFileID int) (*EpisodeFile, error) {
 
 // DeleteEpisodeFile deletes the EpisodeFile with the given ID.
 // This also deletes the media file from disk!
-func (s *Sonarr) DeleteEpisodeFile(episodeFileID int) (*EpisodeFile, error) {
+func (s *Sonarr) DeleteEpisodeFile(ctx context.Context, episodeFileID int) (*EpisodeFile, error) {
 	results := &EpisodeFile{}
 	if episodeFileID <= 0 {
 		return results, errors.New("episodeFileID must be a positive integer")
 	}
 	episodeFileURL := fmt.Sprintf("%s/%s", episodeFileEndpoint, strconv.Itoa(episodeFileID))
-	res, err := s.del(episodeFileURL, nil)
+	res, err := s.del(ctx, episodeFileURL, nil)
 	if err != nil {
 		return results, err
 	}
@@ -193,9 +287,9 @@ func (s *Sonarr) DeleteEpisodeFile(episodeFileID int) (*EpisodeFile, error) {
 }
 
 // GetAllSeries retrieves all Series for the given series ID.
-func (s *Sonarr) GetAllSeries() ([]Series, error) {
+func (s *Sonarr) GetAllSeries(ctx context.Context) ([]Series, error) {
 	var results []Series
-	res, err := s.get(seriesEndpoint, nil)
+	res, err := s.get(ctx, seriesEndpoint, nil)
 	if err != nil {
 		return results, err
 	}
@@ -206,13 +300,13 @@ func (s *Sonarr) GetAllSeries() ([]Series, error) {
 }
 
 // GetSeries retrieves the Series with the given ID.
-func (s *Sonarr) GetSeries(seriesID int) (*Series, error) {
+func (s *Sonarr) GetSeries(ctx context.Context, seriesID int) (*Series, error) {
 	results := &Series{}
 	if seriesID <= 0 {
 		return results, errors.New("seriesID must be a positive integer")
 	}
 	seriesURL := fmt.Sprintf("%s/%s", seriesEndpoint, strconv.Itoa(seriesID))
-	res, err := s.get(seriesURL, nil)
+	res, err := s.get(ctx, seriesURL, nil)
 	if err != nil {
 		return results, err
 	}
@@ -225,10 +319,10 @@ func (s *Sonarr) GetSeries(seriesID int) (*Series, error) {
 // UpdateSeries updates the given Series.
 // This should be a Series you have previously retrieved with GetAllSeries()
 // or GetSeries(). The updated Series is returned.
-func (s *Sonarr) UpdateSeries(ser *Series) (*Series, error) {
+func (s *Sonarr) UpdateSeries(ctx context.Context, ser *Series) (*Series, error) {
 	results := &Series{}
 	seriesURL := fmt.Sprintf("%s/%s", seriesEndpoint, strconv.Itoa(ser.ID))
-	res, err := s.put(seriesURL, ser)
+	res, err := s.put(ctx, seriesURL, ser)
 	if err != nil {
 		return results, err
 	}
@@ -240,7 +334,7 @@ func (s *Sonarr) UpdateSeries(ser *Series) (*Series, error) {
 
 // DeleteSeries deletes the Series with the given ID.
 // If deleteFiles is true, the series folder and all files will be deleted too.
-func (s *Sonarr) DeleteSeries(seriesID int, deleteFiles bool) (*Series, error) {
+func (s *Sonarr) DeleteSeries(ctx context.Context, seriesID int, deleteFiles bool) (*Series, error) {
 	results := &Series{}
 	if seriesID <= 0 {
 		return results, errors.New("seriesID must be a positive integer")
@@ -250,7 +344,41 @@ func (s *Sonarr) DeleteSeries(seriesID int, deleteFiles bool) (*Series, error) {
 		params.Set("deleteFiles", "true")
 	}
 	seriesURL := fmt.Sprintf("%s/%s", seriesEndpoint, strconv.Itoa(seriesID))
-	res, err := s.del(seriesURL, params)
+	res, err := s.del(ctx, seriesURL, params)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// LookupSeries searches for a Series by title or external ID (e.g.
+// "tvdb:12345") using Sonarr's connected metadata provider. This does not
+// add anything to Sonarr; pass a result to AddSeries to do that.
+func (s *Sonarr) LookupSeries(ctx context.Context, term string) ([]SeriesLookupResult, error) {
+	var results []SeriesLookupResult
+	if term == "" {
+		return results, errors.New("term is required")
+	}
+	params := make(url.Values)
+	params.Set("term", term)
+	res, err := s.get(ctx, seriesLookupEndpoint, params)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(&results)
+	return results, err
+}
+
+// AddSeries adds a new Series to Sonarr. opts is typically built from a
+// SeriesLookupResult returned by LookupSeries.
+func (s *Sonarr) AddSeries(ctx context.Context, opts AddSeriesOptions) (*Series, error) {
+	results := &Series{}
+	res, err := s.post(ctx, seriesEndpoint, opts)
 	if err != nil {
 		return results, err
 	}
@@ -260,10 +388,139 @@ func (s *Sonarr) DeleteSeries(seriesID int, deleteFiles bool) (*Series, error) {
 	return results, err
 }
 
+// PostCommand queues the named command for execution, merging params into
+// the request body alongside "name". See the typed helpers (RefreshSeries,
+// SeriesSearch, etc.) for the commands Sonarr supports.
+func (s *Sonarr) PostCommand(ctx context.Context, name string, params map[string]interface{}) (*Command, error) {
+	results := &Command{}
+	body := make(map[string]interface{}, len(params)+1)
+	for k, v := range params {
+		body[k] = v
+	}
+	body["name"] = name
+
+	res, err := s.post(ctx, commandEndpoint, body)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// GetCommand retrieves the status of a previously queued Command.
+func (s *Sonarr) GetCommand(ctx context.Context, id int) (*Command, error) {
+	results := &Command{}
+	if id <= 0 {
+		return results, errors.New("id must be a positive integer")
+	}
+	commandURL := fmt.Sprintf("%s/%s", commandEndpoint, strconv.Itoa(id))
+	res, err := s.get(ctx, commandURL, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// GetCommands retrieves the status of all queued and recently completed
+// Commands.
+func (s *Sonarr) GetCommands(ctx context.Context) ([]Command, error) {
+	var results []Command
+	res, err := s.get(ctx, commandEndpoint, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(&results)
+	return results, err
+}
+
+// RefreshSeries queues a refresh of the given series' metadata from the
+// configured metadata provider.
+func (s *Sonarr) RefreshSeries(ctx context.Context, seriesID int) (*Command, error) {
+	return s.PostCommand(ctx, "RefreshSeries", map[string]interface{}{"seriesId": seriesID})
+}
+
+// RescanSeries queues a rescan of the given series' folder on disk.
+func (s *Sonarr) RescanSeries(ctx context.Context, seriesID int) (*Command, error) {
+	return s.PostCommand(ctx, "RescanSeries", map[string]interface{}{"seriesId": seriesID})
+}
+
+// EpisodeSearch queues a search for the given Episodes.
+func (s *Sonarr) EpisodeSearch(ctx context.Context, episodeIDs []int) (*Command, error) {
+	return s.PostCommand(ctx, "EpisodeSearch", map[string]interface{}{"episodeIds": episodeIDs})
+}
+
+// SeasonSearch queues a search for all monitored Episodes in the given
+// season of a Series.
+func (s *Sonarr) SeasonSearch(ctx context.Context, seriesID, season int) (*Command, error) {
+	return s.PostCommand(ctx, "SeasonSearch", map[string]interface{}{
+		"seriesId":     seriesID,
+		"seasonNumber": season,
+	})
+}
+
+// SeriesSearch queues a search for all monitored Episodes of a Series.
+func (s *Sonarr) SeriesSearch(ctx context.Context, seriesID int) (*Command, error) {
+	return s.PostCommand(ctx, "SeriesSearch", map[string]interface{}{"seriesId": seriesID})
+}
+
+// MissingEpisodeSearch queues a search for all monitored Episodes that are
+// missing a file.
+func (s *Sonarr) MissingEpisodeSearch(ctx context.Context) (*Command, error) {
+	return s.PostCommand(ctx, "MissingEpisodeSearch", nil)
+}
+
+// RenameFiles queues a rename of the given EpisodeFiles according to
+// Sonarr's configured naming scheme.
+func (s *Sonarr) RenameFiles(ctx context.Context, files []int) (*Command, error) {
+	return s.PostCommand(ctx, "RenameFiles", map[string]interface{}{"files": files})
+}
+
+// WaitForCommand polls the status of the given Command at the given
+// interval until it reaches a terminal status ("completed" or "failed") or
+// ctx is cancelled. ctx governs each poll, including any retries.
+func (s *Sonarr) WaitForCommand(ctx context.Context, id int, poll time.Duration) (*Command, error) {
+	if id <= 0 {
+		return nil, errors.New("id must be a positive integer")
+	}
+	commandURL := fmt.Sprintf("%s/%s", commandEndpoint, strconv.Itoa(id))
+
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			res, err := s.get(ctx, commandURL, nil)
+			if err != nil {
+				return nil, err
+			}
+			cmd := &Command{}
+			err = json.NewDecoder(res.Body).Decode(cmd)
+			res.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			switch cmd.Status {
+			case "completed", "failed":
+				return cmd, nil
+			}
+		}
+	}
+}
+
 // GetSystemStatus retrieves system information about the Sonarr server.
-func (s *Sonarr) GetSystemStatus() (*SystemStatus, error) {
+func (s *Sonarr) GetSystemStatus(ctx context.Context) (*SystemStatus, error) {
 	results := &SystemStatus{}
-	res, err := s.get(systemStatusEndpoint, nil)
+	res, err := s.get(ctx, systemStatusEndpoint, nil)
 	if err != nil {
 		return results, err
 	}
@@ -273,10 +530,86 @@ func (s *Sonarr) GetSystemStatus() (*SystemStatus, error) {
 	return results, err
 }
 
+// GetQueue retrieves the episodes currently being downloaded.
+func (s *Sonarr) GetQueue(ctx context.Context) ([]Queue, error) {
+	var results []Queue
+	res, err := s.get(ctx, queueEndpoint, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(&results)
+	return results, err
+}
+
+// DeleteQueueItem removes the given item from the download queue. If
+// blacklist is true, the release is also blacklisted so it will not be
+// grabbed again.
+func (s *Sonarr) DeleteQueueItem(ctx context.Context, id int, blacklist bool) error {
+	if id <= 0 {
+		return errors.New("id must be a positive integer")
+	}
+	params := make(url.Values)
+	if blacklist {
+		params.Set("blacklist", "true")
+	}
+	queueURL := fmt.Sprintf("%s/%s", queueEndpoint, strconv.Itoa(id))
+	res, err := s.del(ctx, queueURL, params)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+// GetHistory retrieves a page of Series and Episode history, such as grabs,
+// imports, and deletions.
+func (s *Sonarr) GetHistory(ctx context.Context, opts HistoryOptions) (*PagedResult[HistoryRecord], error) {
+	results := &PagedResult[HistoryRecord]{}
+	res, err := s.get(ctx, historyEndpoint, opts.values())
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// GetWantedMissing retrieves a page of monitored Episodes that are missing
+// a file.
+func (s *Sonarr) GetWantedMissing(ctx context.Context, opts PageOptions) (*PagedResult[Episode], error) {
+	results := &PagedResult[Episode]{}
+	res, err := s.get(ctx, wantedMissingEndpoint, opts.values())
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// GetWantedCutoffUnmet retrieves a page of monitored Episodes whose file
+// does not meet its quality profile's cutoff.
+func (s *Sonarr) GetWantedCutoffUnmet(ctx context.Context, opts PageOptions) (*PagedResult[Episode], error) {
+	results := &PagedResult[Episode]{}
+	res, err := s.get(ctx, wantedCutoffEndpoint, opts.values())
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
 // GetTags retrieves all Tags that have been applied to any series.
-func (s *Sonarr) GetTags() ([]Tag, error) {
+func (s *Sonarr) GetTags(ctx context.Context) ([]Tag, error) {
 	var results []Tag
-	res, err := s.get(tagEndpoint, nil)
+	res, err := s.get(ctx, tagEndpoint, nil)
 	if err != nil {
 		return results, err
 	}
@@ -285,3 +618,318 @@ func (s *Sonarr) GetTags() ([]Tag, error) {
 	err = json.NewDecoder(res.Body).Decode(&results)
 	return results, err
 }
+
+// CreateTag creates a new Tag.
+func (s *Sonarr) CreateTag(ctx context.Context, t Tag) (*Tag, error) {
+	results := &Tag{}
+	res, err := s.post(ctx, tagEndpoint, t)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// UpdateTag updates the given Tag. This should be a Tag you have previously
+// retrieved with GetTags(). The updated Tag is returned.
+func (s *Sonarr) UpdateTag(ctx context.Context, t Tag) (*Tag, error) {
+	results := &Tag{}
+	tagURL := fmt.Sprintf("%s/%s", tagEndpoint, strconv.Itoa(t.ID))
+	res, err := s.put(ctx, tagURL, t)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// DeleteTag deletes the Tag with the given ID.
+func (s *Sonarr) DeleteTag(ctx context.Context, id int) error {
+	if id <= 0 {
+		return errors.New("id must be a positive integer")
+	}
+	tagURL := fmt.Sprintf("%s/%s", tagEndpoint, strconv.Itoa(id))
+	res, err := s.del(ctx, tagURL, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+// GetQualityProfiles retrieves all configured QualityProfiles.
+func (s *Sonarr) GetQualityProfiles(ctx context.Context) ([]QualityProfile, error) {
+	var results []QualityProfile
+	res, err := s.get(ctx, qualityProfileEndpoint, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(&results)
+	return results, err
+}
+
+// GetQualityProfile retrieves the QualityProfile with the given ID.
+func (s *Sonarr) GetQualityProfile(ctx context.Context, id int) (*QualityProfile, error) {
+	results := &QualityProfile{}
+	if id <= 0 {
+		return results, errors.New("id must be a positive integer")
+	}
+	profileURL := fmt.Sprintf("%s/%s", qualityProfileEndpoint, strconv.Itoa(id))
+	res, err := s.get(ctx, profileURL, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// CreateQualityProfile creates a new QualityProfile.
+func (s *Sonarr) CreateQualityProfile(ctx context.Context, p QualityProfile) (*QualityProfile, error) {
+	results := &QualityProfile{}
+	res, err := s.post(ctx, qualityProfileEndpoint, p)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// UpdateQualityProfile updates the given QualityProfile. This should be a
+// QualityProfile you have previously retrieved with GetQualityProfiles() or
+// GetQualityProfile(). The updated QualityProfile is returned.
+func (s *Sonarr) UpdateQualityProfile(ctx context.Context, p QualityProfile) (*QualityProfile, error) {
+	results := &QualityProfile{}
+	profileURL := fmt.Sprintf("%s/%s", qualityProfileEndpoint, strconv.Itoa(p.ID))
+	res, err := s.put(ctx, profileURL, p)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// DeleteQualityProfile deletes the QualityProfile with the given ID.
+func (s *Sonarr) DeleteQualityProfile(ctx context.Context, id int) error {
+	if id <= 0 {
+		return errors.New("id must be a positive integer")
+	}
+	profileURL := fmt.Sprintf("%s/%s", qualityProfileEndpoint, strconv.Itoa(id))
+	res, err := s.del(ctx, profileURL, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+// GetLanguageProfiles retrieves all configured LanguageProfiles.
+func (s *Sonarr) GetLanguageProfiles(ctx context.Context) ([]LanguageProfile, error) {
+	var results []LanguageProfile
+	res, err := s.get(ctx, languageProfileEndpoint, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(&results)
+	return results, err
+}
+
+// GetLanguageProfile retrieves the LanguageProfile with the given ID.
+func (s *Sonarr) GetLanguageProfile(ctx context.Context, id int) (*LanguageProfile, error) {
+	results := &LanguageProfile{}
+	if id <= 0 {
+		return results, errors.New("id must be a positive integer")
+	}
+	profileURL := fmt.Sprintf("%s/%s", languageProfileEndpoint, strconv.Itoa(id))
+	res, err := s.get(ctx, profileURL, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// CreateLanguageProfile creates a new LanguageProfile.
+func (s *Sonarr) CreateLanguageProfile(ctx context.Context, p LanguageProfile) (*LanguageProfile, error) {
+	results := &LanguageProfile{}
+	res, err := s.post(ctx, languageProfileEndpoint, p)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// UpdateLanguageProfile updates the given LanguageProfile. This should be a
+// LanguageProfile you have previously retrieved with GetLanguageProfiles()
+// or GetLanguageProfile(). The updated LanguageProfile is returned.
+func (s *Sonarr) UpdateLanguageProfile(ctx context.Context, p LanguageProfile) (*LanguageProfile, error) {
+	results := &LanguageProfile{}
+	profileURL := fmt.Sprintf("%s/%s", languageProfileEndpoint, strconv.Itoa(p.ID))
+	res, err := s.put(ctx, profileURL, p)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// DeleteLanguageProfile deletes the LanguageProfile with the given ID.
+func (s *Sonarr) DeleteLanguageProfile(ctx context.Context, id int) error {
+	if id <= 0 {
+		return errors.New("id must be a positive integer")
+	}
+	profileURL := fmt.Sprintf("%s/%s", languageProfileEndpoint, strconv.Itoa(id))
+	res, err := s.del(ctx, profileURL, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+// GetRootFolders retrieves all configured RootFolders.
+func (s *Sonarr) GetRootFolders(ctx context.Context) ([]RootFolder, error) {
+	var results []RootFolder
+	res, err := s.get(ctx, rootFolderEndpoint, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(&results)
+	return results, err
+}
+
+// GetRootFolder retrieves the RootFolder with the given ID.
+func (s *Sonarr) GetRootFolder(ctx context.Context, id int) (*RootFolder, error) {
+	results := &RootFolder{}
+	if id <= 0 {
+		return results, errors.New("id must be a positive integer")
+	}
+	rootFolderURL := fmt.Sprintf("%s/%s", rootFolderEndpoint, strconv.Itoa(id))
+	res, err := s.get(ctx, rootFolderURL, nil)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// CreateRootFolder registers a new RootFolder at the given path.
+func (s *Sonarr) CreateRootFolder(ctx context.Context, path string) (*RootFolder, error) {
+	results := &RootFolder{}
+	if path == "" {
+		return results, errors.New("path is required")
+	}
+	res, err := s.post(ctx, rootFolderEndpoint, RootFolder{Path: path})
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// DeleteRootFolder deletes the RootFolder with the given ID. This does not
+// delete anything on disk.
+func (s *Sonarr) DeleteRootFolder(ctx context.Context, id int) error {
+	if id <= 0 {
+		return errors.New("id must be a positive integer")
+	}
+	rootFolderURL := fmt.Sprintf("%s/%s", rootFolderEndpoint, strconv.Itoa(id))
+	res, err := s.del(ctx, rootFolderURL, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+// GetReleases performs an interactive search for releases matching the
+// given Episode. This is only available on Sonarr v3 and later; create the
+// client with WithAPIVersion(APIv3).
+func (s *Sonarr) GetReleases(ctx context.Context, episodeID int) ([]Release, error) {
+	var results []Release
+	if episodeID <= 0 {
+		return results, errors.New("episodeID must be a positive integer")
+	}
+	params := make(url.Values)
+	params.Set("episodeId", strconv.Itoa(episodeID))
+	res, err := s.get(ctx, releaseEndpoint, params)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(&results)
+	return results, err
+}
+
+// DownloadRelease grabs the release identified by guid, as returned by
+// GetReleases. This is only available on Sonarr v3 and later; create the
+// client with WithAPIVersion(APIv3).
+func (s *Sonarr) DownloadRelease(ctx context.Context, guid string, indexerID int) (*Release, error) {
+	results := &Release{}
+	if guid == "" {
+		return results, errors.New("guid is required")
+	}
+	payload := struct {
+		GUID      string `json:"guid"`
+		IndexerID int    `json:"indexerId"`
+	}{guid, indexerID}
+	res, err := s.post(ctx, releaseEndpoint, payload)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
+
+// Parse extracts series, season, and episode information from a release
+// title. This is only available on Sonarr v3 and later; create the client
+// with WithAPIVersion(APIv3).
+func (s *Sonarr) Parse(ctx context.Context, title string) (*ParseResult, error) {
+	results := &ParseResult{}
+	if title == "" {
+		return results, errors.New("title is required")
+	}
+	params := make(url.Values)
+	params.Set("title", title)
+	res, err := s.get(ctx, parseEndpoint, params)
+	if err != nil {
+		return results, err
+	}
+	defer res.Body.Close()
+
+	err = json.NewDecoder(res.Body).Decode(results)
+	return results, err
+}
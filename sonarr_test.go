@@ -0,0 +1,72 @@
+package sonarr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitForCommandStopsOnContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Command{ID: 1, Status: "started"})
+	}))
+	defer server.Close()
+
+	s, err := New(server.URL, "apikey")
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = s.WaitForCommand(ctx, 1, 10*time.Millisecond)
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed > 500*time.Millisecond {
+		t.Errorf("WaitForCommand() took %v to return after cancellation, want well under that", elapsed)
+	}
+}
+
+func TestWaitForCommandStopsAtTerminalStatus(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		status := "started"
+		if requests >= 2 {
+			status = "completed"
+		}
+		json.NewEncoder(w).Encode(Command{ID: 1, Status: status})
+	}))
+	defer server.Close()
+
+	s, err := New(server.URL, "apikey")
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	cmd, err := s.WaitForCommand(ctx, 1, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForCommand() returned error: %v", err)
+	}
+	if cmd.Status != "completed" {
+		t.Errorf("cmd.Status = %q, want %q", cmd.Status, "completed")
+	}
+
+	requestsAtReturn := requests
+	time.Sleep(50 * time.Millisecond)
+	if requests != requestsAtReturn {
+		t.Errorf("WaitForCommand() kept polling after reaching a terminal status: requests went from %d to %d", requestsAtReturn, requests)
+	}
+}
@@ -2,70 +2,223 @@ package sonarr
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
-func (s *Sonarr) get(endpoint string, params url.Values) (*http.Response, error) {
-	relativeURL, err := url.Parse(endpoint)
+// RetryConfig controls how a Sonarr client retries requests that fail with a
+// 5xx status or a 429 (Too Many Requests). Delays back off exponentially
+// from BaseDelay, capped at MaxDelay, unless Sonarr sends a Retry-After
+// header.
+type RetryConfig struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// APIError is returned when Sonarr responds with a non-2xx status. Callers
+// can use errors.As to recover the status code and the error payload Sonarr
+// returned, if any.
+type APIError struct {
+	StatusCode   int
+	Message      string
+	Description  string
+	PropertyName string
+	URL          string
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("sonarr: %s: %d %s", e.URL, e.StatusCode, msg)
+}
+
+func newAPIError(res *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		URL:        res.Request.URL.String(),
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+
+	var payload struct {
+		Message      string `json:"message"`
+		Description  string `json:"description"`
+		PropertyName string `json:"propertyName"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Message = payload.Message
+		apiErr.Description = payload.Description
+		apiErr.PropertyName = payload.PropertyName
+	} else {
+		apiErr.Message = string(body)
+	}
+
+	return apiErr
+}
+
+// newRequestID generates a short, unique-enough value for the X-Request-Id
+// header so requests can be correlated in logs on either side.
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// retryDelay determines how long to wait before retrying res, honoring a
+// Retry-After header if Sonarr sent one.
+func retryDelay(res *http.Response, attempt int, retry RetryConfig) time.Duration {
+	if retryAfter := res.Header.Get("Retry-After"); retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if at, err := http.ParseTime(retryAfter); err == nil {
+			if d := time.Until(at); d > 0 {
+				return d
+			}
+		}
+	}
+
+	delay := retry.BaseDelay << attempt
+	if retry.MaxDelay > 0 && delay > retry.MaxDelay {
+		delay = retry.MaxDelay
+	}
+	return delay
+}
+
+// do sends an HTTP request through the client's middleware chain: it
+// attaches the API key and a request ID, waits on the rate limiter if one is
+// configured, and retries on 5xx/429 responses per s.retry. A non-2xx
+// response is returned as an *APIError.
+func (s *Sonarr) do(ctx context.Context, method string, endpointURL *url.URL, body []byte) (*http.Response, error) {
+	if s.limiter != nil {
+		if err := s.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var res *http.Response
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, endpointURL.String(), reqBody)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Api-Key", s.apiKey)
+		req.Header.Set("X-Request-Id", newRequestID())
+		if s.userAgent != "" {
+			req.Header.Set("User-Agent", s.userAgent)
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		res, err = s.HTTPClient.Do(req)
+		if err != nil {
+			return res, err
+		}
+
+		retryable := res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests
+		if !retryable || attempt >= s.retry.MaxRetries {
+			break
+		}
+
+		delay := retryDelay(res, attempt, s.retry)
+		res.Body.Close()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		defer res.Body.Close()
+		return nil, newAPIError(res)
+	}
+
+	return res, nil
+}
+
+func (s *Sonarr) resolve(endpoint string, params url.Values) (*url.URL, error) {
+	relativeURL, err := url.Parse(string(s.apiVersion) + endpoint)
 	if err != nil {
-		return &http.Response{}, err
+		return nil, err
 	}
 	endpointURL := s.baseURL.ResolveReference(relativeURL)
-	if params == nil {
-		params = endpointURL.Query()
+	if params != nil {
+		endpointURL.RawQuery = params.Encode()
 	}
-	params.Set("apikey", s.apiKey)
-	endpointURL.RawQuery = params.Encode()
+	return endpointURL, nil
+}
 
-	req, err := http.NewRequest("GET", endpointURL.String(), nil)
+func (s *Sonarr) get(ctx context.Context, endpoint string, params url.Values) (*http.Response, error) {
+	endpointURL, err := s.resolve(endpoint, params)
 	if err != nil {
-		return &http.Response{}, err
+		return nil, err
 	}
-
-	return s.HTTPClient.Do(req)
+	return s.do(ctx, http.MethodGet, endpointURL, nil)
 }
 
-func (s *Sonarr) put(endpoint string, payload interface{}) (*http.Response, error) {
+func (s *Sonarr) post(ctx context.Context, endpoint string, payload interface{}) (*http.Response, error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return &http.Response{}, err
+		return nil, err
 	}
-	relativeURL, err := url.Parse(endpoint)
+	endpointURL, err := s.resolve(endpoint, nil)
 	if err != nil {
-		return &http.Response{}, err
+		return nil, err
 	}
-	endpointURL := s.baseURL.ResolveReference(relativeURL)
-
-	params := endpointURL.Query()
-	params.Set("apikey", s.apiKey)
-	endpointURL.RawQuery = params.Encode()
+	return s.do(ctx, http.MethodPost, endpointURL, body)
+}
 
-	req, err := http.NewRequest("PUT", endpointURL.String(), bytes.NewBuffer(body))
+func (s *Sonarr) put(ctx context.Context, endpoint string, payload interface{}) (*http.Response, error) {
+	body, err := json.Marshal(payload)
 	if err != nil {
-		return &http.Response{}, err
+		return nil, err
 	}
-
-	return s.HTTPClient.Do(req)
+	endpointURL, err := s.resolve(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.do(ctx, http.MethodPut, endpointURL, body)
 }
 
-func (s *Sonarr) del(endpoint string, params url.Values) (*http.Response, error) {
-	relativeURL, err := url.Parse(endpoint)
+func (s *Sonarr) patch(ctx context.Context, endpoint string, payload interface{}) (*http.Response, error) {
+	body, err := json.Marshal(payload)
 	if err != nil {
-		return &http.Response{}, err
+		return nil, err
 	}
-	endpointURL := s.baseURL.ResolveReference(relativeURL)
-	if params == nil {
-		params = endpointURL.Query()
+	endpointURL, err := s.resolve(endpoint, nil)
+	if err != nil {
+		return nil, err
 	}
-	params.Set("apikey", s.apiKey)
-	endpointURL.RawQuery = params.Encode()
+	return s.do(ctx, http.MethodPatch, endpointURL, body)
+}
 
-	req, err := http.NewRequest("DELETE", endpointURL.String(), nil)
+func (s *Sonarr) del(ctx context.Context, endpoint string, params url.Values) (*http.Response, error) {
+	endpointURL, err := s.resolve(endpoint, params)
 	if err != nil {
-		return &http.Response{}, err
+		return nil, err
 	}
-
-	return s.HTTPClient.Do(req)
+	return s.do(ctx, http.MethodDelete, endpointURL, nil)
 }
@@ -0,0 +1,119 @@
+package sonarr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestDoRetriesUntilSuccess(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s, err := New(server.URL, "apikey", WithRetry(RetryConfig{
+		MaxRetries: 2,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   10 * time.Millisecond,
+	}))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	res, err := s.get(context.Background(), "", nil)
+	if err != nil {
+		t.Fatalf("get() returned error: %v", err)
+	}
+	res.Body.Close()
+
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3 (1 initial + 2 retries)", attempts)
+	}
+}
+
+func TestDoHonorsRetryAfter(t *testing.T) {
+	var attempts int
+	var firstAttempt time.Time
+	var retried time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			firstAttempt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		retried = time.Now()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s, err := New(server.URL, "apikey", WithRetry(RetryConfig{
+		MaxRetries: 1,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   time.Millisecond,
+	}))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	res, err := s.get(context.Background(), "", nil)
+	if err != nil {
+		t.Fatalf("get() returned error: %v", err)
+	}
+	res.Body.Close()
+
+	if delay := retried.Sub(firstAttempt); delay < 900*time.Millisecond {
+		t.Errorf("retried after %v, want at least ~1s (Retry-After should win over BaseDelay)", delay)
+	}
+}
+
+func TestDoStopsPromptlyOnContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	s, err := New(server.URL, "apikey", WithRetry(RetryConfig{
+		MaxRetries: 10,
+		BaseDelay:  time.Second,
+		MaxDelay:   time.Second,
+	}))
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = s.get(ctx, "", nil)
+	elapsed := time.Since(start)
+
+	if err != context.DeadlineExceeded {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed > 500*time.Millisecond {
+		t.Errorf("get() took %v to return after cancellation, want well under the 1s backoff", elapsed)
+	}
+}
+
+func TestRetryDelayPrefersRetryAfterSeconds(t *testing.T) {
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", strconv.Itoa(2))
+
+	retry := RetryConfig{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	if d := retryDelay(res, 0, retry); d != 2*time.Second {
+		t.Errorf("retryDelay() = %v, want 2s", d)
+	}
+}